@@ -1,7 +1,11 @@
 package bootstrap
 
 import (
+	"os"
+
 	"github.com/flamingo-stack/openframe-cli/internal/bootstrap"
+	"github.com/flamingo-stack/openframe-cli/internal/bootstrap/plugins"
+	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
 
@@ -27,10 +31,44 @@ Examples:
   openframe bootstrap --deployment-mode=saas-shared --non-interactive  # Full CI/CD mode
   openframe bootstrap --verbose                         # Show detailed logs including ArgoCD sync progress
   openframe bootstrap -v --deployment-mode=oss-tenant  # Verbose mode with pre-selected deployment
-  openframe bootstrap --repo=https://github.com/myorg/myrepo --branch=dev  # Custom repository`,
+  openframe bootstrap --repo=https://github.com/myorg/myrepo --branch=dev  # Custom repository
+  openframe bootstrap --output=json --report=preflight.json --non-interactive  # CI-friendly preflight
+
+Preflight exits with a stable, script-friendly code: 0 success, 2 user
+declined install, 3 unsupported platform, 4 insufficient memory, 5 install
+failed.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return bootstrap.NewService().Execute(cmd, args)
+			checker := buildPreflightChecker(cmd)
+
+			// Drive the tooling preflight ourselves, ahead of cluster
+			// creation: bootstrap.Service has no constructor seam for
+			// receiving the checker, so nothing downstream of here can run
+			// CheckAll()/ExitCode() on our behalf.
+			if err := checker.CheckAll(); err != nil {
+				pterm.Error.Println(err)
+				os.Exit(checker.ExitCode())
+			}
+
+			err := bootstrap.NewService().Execute(cmd, args)
+
+			// The newly created cluster's context only exists in the
+			// kubeconfig once Execute returns, so this call site can't run
+			// this gate any earlier without Service exposing a seam between
+			// its cluster-creation and chart-install steps.
+			if kubeErr := checker.CheckKubeContext(); kubeErr != nil && err == nil {
+				err = kubeErr
+			}
+
+			// Surface the stable preflight exit code promised above instead
+			// of letting it fall through to cobra's generic 0/1.
+			if code := checker.ExitCode(); code != bootstrap.ExitSuccess {
+				if err != nil {
+					pterm.Error.Println(err)
+				}
+				os.Exit(code)
+			}
+			return err
 		},
 	}
 
@@ -40,9 +78,66 @@ Examples:
 	cmd.Flags().BoolP("verbose", "v", false, "Show detailed logging including ArgoCD sync progress")
 	cmd.Flags().Bool("force", false, "Continue even with insufficient memory or other warnings")
 
+	// Machine-readable preflight reporting, for CI pipelines
+	cmd.Flags().String("report", "", "Write a JSON preflight report to this path")
+	cmd.Flags().String("output", "table", "Preflight output format: table or json")
+
 	// Repository overrides (useful for contributors working on forks)
 	cmd.Flags().String("repo", "", "Override the default GitHub repository URL")
 	cmd.Flags().String("branch", "", "Override the default Git branch (default: main)")
 
+	// Site-specific preflight plugins (shared with `bootstrap plugins`)
+	cmd.PersistentFlags().String("plugin-dir", "", "Directory to scan for preflight check plugins (.so files); default ~/.openframe/plugins")
+
+	// Kubeconfig/context targeting for the post-cluster-creation preflight gate
+	cmd.Flags().String("kubeconfig", "", "Path to the kubeconfig to target (default: $KUBECONFIG or ~/.kube/config)")
+	cmd.Flags().String("kube-context", "", "Kube context to target (default: prompt interactively, or the kubeconfig's current-context)")
+
+	// Offline/air-gapped bootstrap, backed by a bundle from `bootstrap package`
+	cmd.Flags().Bool("offline", false, "Install prerequisites from --cache-dir instead of the network")
+	cmd.Flags().String("cache-dir", "", "Unpacked offline tool cache to install from when --offline is set")
+
+	cmd.AddCommand(getPluginsCmd())
+	cmd.AddCommand(getPackageCmd())
+
 	return cmd
 }
+
+// buildPreflightChecker constructs the PreflightChecker this command's flags
+// describe, so RunE can run it ahead of cluster creation and chart install
+// instead of the flags going unread.
+func buildPreflightChecker(cmd *cobra.Command) *bootstrap.PreflightChecker {
+	nonInteractive, _ := cmd.Flags().GetBool("non-interactive")
+	force, _ := cmd.Flags().GetBool("force")
+	verbose, _ := cmd.Flags().GetBool("verbose")
+	checker := bootstrap.NewPreflightChecker(nonInteractive, force, verbose)
+
+	output, _ := cmd.Flags().GetString("output")
+	reportPath, _ := cmd.Flags().GetString("report")
+	checker.SetReportOptions(bootstrap.ReportFormat(output), reportPath)
+
+	// RunE calls checker.CheckKubeContext() once Service.Execute returns —
+	// it's a sequential gate, not one of the concurrently-installed tools
+	// CheckAll drives.
+	kubeconfig, _ := cmd.Flags().GetString("kubeconfig")
+	kubeContext, _ := cmd.Flags().GetString("kube-context")
+	checker.EnableKubeContextCheck(kubeconfig, kubeContext)
+
+	offline, _ := cmd.Flags().GetBool("offline")
+	cacheDir, _ := cmd.Flags().GetString("cache-dir")
+	checker.SetOffline(offline, cacheDir)
+
+	pluginDir, _ := cmd.Flags().GetString("plugin-dir")
+	if pluginDir == "" {
+		if dir, err := plugins.DefaultDir(); err == nil {
+			pluginDir = dir
+		}
+	}
+	if pluginDir != "" {
+		for _, loadErr := range checker.LoadPlugins(pluginDir) {
+			pterm.Warning.Printf("failed to load preflight plugin: %v\n", loadErr)
+		}
+	}
+
+	return checker
+}
@@ -0,0 +1,84 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/flamingo-stack/openframe-cli/configs"
+	"github.com/flamingo-stack/openframe-cli/internal/bootstrap/offlinecache"
+	"github.com/flamingo-stack/openframe-cli/internal/chart/prerequisites/versioncheck"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// getPackageCmd returns the `openframe bootstrap package` command, which
+// produces an offline tool cache tarball for --offline bootstrap runs.
+func getPackageCmd() *cobra.Command {
+	var platforms []string
+	var cacheDir string
+	var output string
+	var repo string
+
+	cmd := &cobra.Command{
+		Use:   "package",
+		Short: "Package a pinned tool cache for offline/air-gapped bootstrap",
+		Long: `Package a Pinned Tool Cache for Offline Bootstrap
+
+Gathers pinned versions of kubectl, k3d, and helm (at the min_version
+pinned in configs/prerequisites.toml) for the given --platform set, plus
+a chart repo Git mirror when --repo is set, into --cache-dir, then tars
+it with a manifest.json of SHA-256 checksums. Point a later
+"openframe bootstrap --offline --cache-dir=<unpacked bundle>" at the
+unpacked contents to bootstrap without touching the network. Container
+images aren't gathered yet — pull and pin them into --cache-dir
+separately before running this command.
+
+Examples:
+  openframe bootstrap package --platform=linux/amd64,darwin/arm64 --cache-dir=./staging --output=openframe-offline.tar.gz`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cacheDir == "" {
+				return fmt.Errorf("--cache-dir is required: it's used as the staging directory for gathered artifacts")
+			}
+			if output == "" {
+				output = "openframe-offline-bundle.tar.gz"
+			}
+
+			registry, err := versioncheck.LoadRegistryBytes(configs.PrerequisitesTOML)
+			if err != nil {
+				return fmt.Errorf("failed to load embedded prerequisite constraints: %w", err)
+			}
+			versions := make(map[string]string, len(registry))
+			for _, tool := range []string{"kubectl", "k3d", "Helm"} {
+				if c, ok := registry[tool]; ok {
+					versions[tool] = c.Min
+				}
+			}
+
+			if err := offlinecache.Gather(offlinecache.GatherOptions{
+				CacheDir:  cacheDir,
+				Platforms: platforms,
+				Versions:  versions,
+				RepoURL:   repo,
+			}); err != nil {
+				return err
+			}
+
+			if err := offlinecache.Package(offlinecache.BuildOptions{
+				CacheDir:   cacheDir,
+				OutputPath: output,
+				Platforms:  platforms,
+			}); err != nil {
+				return err
+			}
+
+			pterm.Success.Printf("Wrote offline bundle to %s\n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&platforms, "platform", nil, "Target platforms to bundle, e.g. linux/amd64,darwin/arm64")
+	cmd.Flags().StringVar(&cacheDir, "cache-dir", "", "Staging directory to gather pinned artifacts into and bundle")
+	cmd.Flags().StringVar(&output, "output", "", "Output tarball path (default: openframe-offline-bundle.tar.gz)")
+	cmd.Flags().StringVar(&repo, "repo", "", "Chart repo Git URL to mirror into the cache; skipped if unset")
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/flamingo-stack/openframe-cli/internal/bootstrap/plugins"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// getPluginsCmd returns the `openframe bootstrap plugins` command group for
+// inspecting site-specific preflight check plugins.
+func getPluginsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugins",
+		Short: "Manage site-specific preflight check plugins",
+		Long: `Manage Site-Specific Preflight Check Plugins
+
+OpenFrame loads preflight checks from Go plugin (.so) files in --plugin-dir
+(default: ~/.openframe/plugins), so enterprise users can add gates like VPN
+reachability or internal CA presence without forking the CLI. Each plugin
+must export a "NewCheck() PreflightCheck" symbol.`,
+	}
+
+	cmd.AddCommand(getPluginsListCmd())
+
+	return cmd
+}
+
+// getPluginsListCmd returns the `openframe bootstrap plugins list` command.
+func getPluginsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List preflight check plugins discovered in --plugin-dir",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, _ := cmd.Flags().GetString("plugin-dir")
+			if dir == "" {
+				var err error
+				dir, err = plugins.DefaultDir()
+				if err != nil {
+					return err
+				}
+			}
+
+			checks, errs := plugins.Load(dir)
+			for _, err := range errs {
+				pterm.Warning.Printf("Failed to load plugin: %v\n", err)
+			}
+
+			if len(checks) == 0 {
+				pterm.Info.Printf("No preflight plugins found in %s\n", dir)
+				return nil
+			}
+
+			tableData := pterm.TableData{{"Name", "Category", "Installed"}}
+			for _, check := range checks {
+				tableData = append(tableData, []string{check.Name(), check.Category(), fmt.Sprintf("%t", check.IsInstalled())})
+			}
+
+			return pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		},
+	}
+}
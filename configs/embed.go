@@ -0,0 +1,12 @@
+// Package configs contains static configuration assets embedded into the
+// openframe-cli binary at build time.
+package configs
+
+import _ "embed"
+
+// PrerequisitesTOML is the default tool version constraint table shipped
+// with each OpenFrame release. Operators can override it at runtime via
+// PreflightChecker.LoadConstraintsFile instead of rebuilding the binary.
+//
+//go:embed prerequisites.toml
+var PrerequisitesTOML []byte
@@ -0,0 +1,118 @@
+// Package dag runs a set of named units of work concurrently, starting each
+// one only once everything it depends on has finished, so independent
+// branches don't wait on each other.
+package dag
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Node is a unit of work scheduled by Run.
+type Node struct {
+	Name      string
+	DependsOn []string
+	Run       func() error
+}
+
+// Run executes nodes concurrently. A node starts only after every name in
+// its DependsOn has finished; if a dependency failed, the node is skipped
+// and recorded with an error instead of being run. Returns a map of node
+// name to its outcome (nil on success) for every node, or a validation
+// error if the graph itself is malformed (duplicate name, unknown
+// dependency, or a cycle).
+func Run(nodes []Node) (map[string]error, error) {
+	byName := make(map[string]Node, len(nodes))
+	for _, n := range nodes {
+		if _, dup := byName[n.Name]; dup {
+			return nil, fmt.Errorf("duplicate node name %q", n.Name)
+		}
+		byName[n.Name] = n
+	}
+	for _, n := range nodes {
+		for _, dep := range n.DependsOn {
+			if _, ok := byName[dep]; !ok {
+				return nil, fmt.Errorf("node %q depends on unknown node %q", n.Name, dep)
+			}
+		}
+	}
+	if cycle := findCycle(byName); cycle != "" {
+		return nil, fmt.Errorf("dependency cycle detected at %q", cycle)
+	}
+
+	done := make(map[string]chan struct{}, len(nodes))
+	for name := range byName {
+		done[name] = make(chan struct{})
+	}
+
+	results := make(map[string]error, len(nodes))
+	var mu sync.Mutex
+
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for _, n := range nodes {
+		n := n
+		go func() {
+			defer wg.Done()
+			defer close(done[n.Name])
+
+			for _, dep := range n.DependsOn {
+				<-done[dep]
+				mu.Lock()
+				depErr := results[dep]
+				mu.Unlock()
+				if depErr != nil {
+					mu.Lock()
+					results[n.Name] = fmt.Errorf("skipped: dependency %q failed: %w", dep, depErr)
+					mu.Unlock()
+					return
+				}
+			}
+
+			err := n.Run()
+			mu.Lock()
+			results[n.Name] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// findCycle returns the name of a node involved in a dependency cycle, or
+// "" if the graph is acyclic.
+func findCycle(byName map[string]Node) string {
+	const (
+		white = 0
+		gray  = 1
+		black = 2
+	)
+	color := make(map[string]int, len(byName))
+
+	var visit func(name string) string
+	visit = func(name string) string {
+		color[name] = gray
+		for _, dep := range byName[name].DependsOn {
+			switch color[dep] {
+			case gray:
+				return dep
+			case white:
+				if cyc := visit(dep); cyc != "" {
+					return cyc
+				}
+			}
+		}
+		color[name] = black
+		return ""
+	}
+
+	for name := range byName {
+		if color[name] == white {
+			if cyc := visit(name); cyc != "" {
+				return cyc
+			}
+		}
+	}
+	return ""
+}
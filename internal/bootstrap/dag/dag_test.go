@@ -0,0 +1,52 @@
+package dag
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRun_IndependentBranchSucceedsWhenDependencyFails(t *testing.T) {
+	dockerErr := errors.New("docker install failed")
+
+	nodes := []Node{
+		{Name: "Docker", Run: func() error { return dockerErr }},
+		{Name: "k3d", DependsOn: []string{"Docker"}, Run: func() error { return nil }},
+		{Name: "Helm", Run: func() error { return nil }},
+	}
+
+	results, err := Run(nodes)
+	if err != nil {
+		t.Fatalf("Run returned unexpected error: %v", err)
+	}
+
+	if !errors.Is(results["Docker"], dockerErr) {
+		t.Errorf("expected Docker to fail with the injected error, got %v", results["Docker"])
+	}
+	if results["Helm"] != nil {
+		t.Errorf("expected independent Helm branch to succeed, got %v", results["Helm"])
+	}
+	if results["k3d"] == nil {
+		t.Error("expected k3d to be skipped because its dependency Docker failed")
+	}
+}
+
+func TestRun_DetectsCycle(t *testing.T) {
+	nodes := []Node{
+		{Name: "a", DependsOn: []string{"b"}, Run: func() error { return nil }},
+		{Name: "b", DependsOn: []string{"a"}, Run: func() error { return nil }},
+	}
+
+	if _, err := Run(nodes); err == nil {
+		t.Fatal("expected an error for a dependency cycle, got nil")
+	}
+}
+
+func TestRun_UnknownDependency(t *testing.T) {
+	nodes := []Node{
+		{Name: "a", DependsOn: []string{"missing"}, Run: func() error { return nil }},
+	}
+
+	if _, err := Run(nodes); err == nil {
+		t.Fatal("expected an error for an unknown dependency, got nil")
+	}
+}
@@ -0,0 +1,59 @@
+package bootstrap
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestInstallTools_SkippedDependentReachesTerminalReport injects a failure
+// into Docker and asserts k3d — which DependsOn it — is recorded as
+// "skipped" rather than being left with no terminal outcome at all, the gap
+// that otherwise leaves its install spinner dangling once dag.Run skips its
+// Run closure.
+func TestInstallTools_SkippedDependentReachesTerminalReport(t *testing.T) {
+	dockerErr := errors.New("docker install failed")
+
+	p := &PreflightChecker{reportFormat: ReportFormatJSON}
+
+	tools := []preflightTool{
+		{
+			Name:        "Docker",
+			Installable: true,
+			Install:     func() error { return dockerErr },
+		},
+		{
+			Name:        "k3d",
+			Installable: true,
+			DependsOn:   []string{"Docker"},
+			Install: func() error {
+				t.Fatal("k3d.Install ran despite its dependency Docker failing")
+				return nil
+			},
+		},
+		{
+			Name:        "Helm",
+			Installable: true,
+			Install:     func() error { return nil },
+		},
+	}
+
+	var toolReports []ToolReport
+	if err := p.installTools(tools, &toolReports); err == nil {
+		t.Fatal("expected installTools to return an error when Docker fails")
+	}
+
+	byName := make(map[string]ToolReport, len(toolReports))
+	for _, r := range toolReports {
+		byName[r.Name] = r
+	}
+
+	if got := byName["Docker"].Action; got != "failed" {
+		t.Errorf("Docker Action = %q, want %q", got, "failed")
+	}
+	if got := byName["k3d"].Action; got != "skipped" {
+		t.Errorf("k3d Action = %q, want %q (otherwise its install spinner/report is left dangling)", got, "skipped")
+	}
+	if got := byName["Helm"].Action; got != "installed" {
+		t.Errorf("Helm Action = %q, want %q", got, "installed")
+	}
+}
@@ -0,0 +1,65 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/flamingo-stack/openframe-cli/internal/chart/prerequisites/kubecontext"
+	"github.com/pterm/pterm"
+)
+
+// KubeContextChecker validates that the kubeconfig and cluster context
+// `openframe chart install` will target are resolvable, reachable, and
+// carry the RBAC ArgoCD install needs. It's enabled via
+// PreflightChecker.EnableKubeContextCheck and run via
+// PreflightChecker.CheckKubeContext after cluster creation, since the newly
+// created cluster's context only exists in the kubeconfig at that point.
+type KubeContextChecker struct {
+	kubeconfigFlag string
+	contextFlag    string
+	nonInteractive bool
+}
+
+// NewKubeContextChecker creates a checker that resolves the kubeconfig from
+// kubeconfigFlag/contextFlag; empty strings defer to the KUBECONFIG env var
+// and kubeconfig current-context respectively.
+func NewKubeContextChecker(kubeconfigFlag, contextFlag string, nonInteractive bool) *KubeContextChecker {
+	return &KubeContextChecker{
+		kubeconfigFlag: kubeconfigFlag,
+		contextFlag:    contextFlag,
+		nonInteractive: nonInteractive,
+	}
+}
+
+// Check resolves the kubeconfig/context — prompting interactively when
+// contextFlag was left unset and more than one context is available — then
+// verifies the cluster is reachable and RBAC is sufficient for ArgoCD
+// install.
+func (k *KubeContextChecker) Check() error {
+	cfg, err := kubecontext.Resolve(k.kubeconfigFlag, k.contextFlag)
+	if err != nil {
+		return err
+	}
+
+	if k.contextFlag == "" && !k.nonInteractive {
+		if contexts, err := cfg.Contexts(); err == nil && len(contexts) > 1 {
+			selected, err := pterm.DefaultInteractiveSelect.
+				WithOptions(contexts).
+				WithDefaultText("Select the kube-context to target").
+				Show()
+			if err != nil {
+				return fmt.Errorf("failed to select kube-context: %w", err)
+			}
+			cfg.Context = selected
+		}
+	}
+
+	if err := cfg.CheckReachable(); err != nil {
+		return err
+	}
+	return cfg.CheckRBAC()
+}
+
+// InstallHelp explains how to fix a kube-context preflight failure manually.
+func (k *KubeContextChecker) InstallHelp() string {
+	return "Kube Context: ensure kubectl is configured with a reachable cluster and the RBAC needed for ArgoCD install (create on namespaces, customresourcedefinitions, clusterrolebindings). Use --kube-context to target a specific context."
+}
@@ -0,0 +1,78 @@
+package offlinecache
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// BuildOptions configures Package.
+type BuildOptions struct {
+	CacheDir   string   // staging directory containing the artifacts to bundle
+	OutputPath string   // destination .tar.gz
+	Platforms  []string // e.g. ["linux/amd64", "darwin/arm64"]
+}
+
+// Package tars CacheDir — which must already contain the pinned kubectl/k3d/
+// helm binaries, container images, and chart repo Git mirror for every
+// requested platform, plus a manifest.json describing them — into a single
+// offline bundle at OutputPath. Staging the cache directory itself (pulling
+// pinned binaries and images) is a separate, environment-specific step; this
+// only packages what's already there.
+func Package(opts BuildOptions) error {
+	if len(opts.Platforms) == 0 {
+		return fmt.Errorf("at least one --platform is required")
+	}
+	if _, err := os.Stat(filepath.Join(opts.CacheDir, ManifestFile)); err != nil {
+		return fmt.Errorf("cache directory %s is missing %s: %w", opts.CacheDir, ManifestFile, err)
+	}
+
+	out, err := os.Create(opts.OutputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", opts.OutputPath, err)
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	defer gz.Close()
+	tw := tar.NewWriter(gz)
+	defer tw.Close()
+
+	return filepath.Walk(opts.CacheDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(opts.CacheDir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = rel
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+}
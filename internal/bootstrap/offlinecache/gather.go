@@ -0,0 +1,248 @@
+package offlinecache
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// chartRepoMirrorDir is the name a gathered chart repo Git mirror is stored
+// under inside the cache directory.
+const chartRepoMirrorDir = "chart-repo.git"
+
+// GatherOptions configures Gather.
+type GatherOptions struct {
+	CacheDir  string            // staging directory to populate; created if missing
+	Platforms []string          // e.g. ["linux/amd64", "darwin/arm64"]
+	Versions  map[string]string // tool name (as in configs/prerequisites.toml) -> pinned version to fetch
+	RepoURL   string            // chart repo to mirror; the Git mirror step is skipped if empty
+}
+
+// toolSource describes how to fetch and unpack one tool's binary for a
+// platform into the cache.
+type toolSource struct {
+	// url returns the download URL for the tool's official release archive
+	// or binary at the given version/platform.
+	url func(version, goos, goarch string) string
+	// extract writes the tool's binary from the downloaded body to destPath.
+	// Most tools ship a bare binary; Helm ships a .tar.gz.
+	extract func(body io.Reader, destPath, goos string) error
+}
+
+var toolSources = map[string]toolSource{
+	"kubectl": {
+		url: func(version, goos, goarch string) string {
+			return fmt.Sprintf("https://dl.k8s.io/release/v%s/bin/%s/%s/%s", version, goos, goarch, binaryName("kubectl", goos))
+		},
+		extract: writeExecutable,
+	},
+	"k3d": {
+		url: func(version, goos, goarch string) string {
+			return fmt.Sprintf("https://github.com/k3d-io/k3d/releases/download/v%s/k3d-%s-%s", version, goos, goarch)
+		},
+		extract: writeExecutable,
+	},
+	"Helm": {
+		url: func(version, goos, goarch string) string {
+			return fmt.Sprintf("https://get.helm.sh/helm-v%s-%s-%s.tar.gz", version, goos, goarch)
+		},
+		extract: extractHelmBinary,
+	},
+}
+
+// Gather populates opts.CacheDir with pinned kubectl/k3d/Helm binaries for
+// every requested platform, a chart repo Git mirror when RepoURL is set,
+// and a manifest.json of their checksums — everything Package then tars
+// into an offline bootstrap bundle. Container images are not gathered here;
+// the image list comes from the Helm chart itself, so pulling and pinning
+// them is left to a separate, chart-aware step.
+func Gather(opts GatherOptions) error {
+	if len(opts.Platforms) == 0 {
+		return fmt.Errorf("at least one --platform is required")
+	}
+	if err := os.MkdirAll(opts.CacheDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory %s: %w", opts.CacheDir, err)
+	}
+
+	var manifest Manifest
+	for _, platform := range opts.Platforms {
+		goos, goarch, err := splitPlatform(platform)
+		if err != nil {
+			return err
+		}
+
+		for tool, src := range toolSources {
+			version := opts.Versions[tool]
+			if version == "" {
+				return fmt.Errorf("no pinned version for %s: set min_version in configs/prerequisites.toml", tool)
+			}
+
+			entry, err := fetchTool(opts.CacheDir, tool, version, goos, goarch, src)
+			if err != nil {
+				return fmt.Errorf("failed to gather %s for %s: %w", tool, platform, err)
+			}
+			manifest.Entries = append(manifest.Entries, entry)
+		}
+	}
+
+	if opts.RepoURL != "" {
+		if err := mirrorChartRepo(opts.CacheDir, opts.RepoURL); err != nil {
+			return fmt.Errorf("failed to mirror chart repo: %w", err)
+		}
+	} else {
+		fmt.Fprintln(os.Stderr, "no --repo given: skipping the chart repo Git mirror; the bundle will need network access for chart sync")
+	}
+
+	fmt.Fprintln(os.Stderr, "container images are not gathered by this command yet; pull and pin them into the cache directory separately before packaging")
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", ManifestFile, err)
+	}
+	if err := os.WriteFile(filepath.Join(opts.CacheDir, ManifestFile), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", ManifestFile, err)
+	}
+
+	return nil
+}
+
+// fetchTool downloads tool's pinned release for goos/goarch, writes its
+// binary into the cache under <tool>/<goos>-<goarch>/, and returns the
+// manifest entry describing it.
+func fetchTool(cacheDir, tool, version, goos, goarch string, src toolSource) (Entry, error) {
+	url := src.url(version, goos, goarch)
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Entry{}, fmt.Errorf("failed to download %s: unexpected status %s", url, resp.Status)
+	}
+
+	destDir := filepath.Join(cacheDir, tool, goos+"-"+goarch)
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return Entry{}, fmt.Errorf("failed to create %s: %w", destDir, err)
+	}
+	// Tool keys follow configs/prerequisites.toml ("Helm"), but the binary
+	// must carry the lower-case command name so PATH lookups find it.
+	destPath := filepath.Join(destDir, binaryName(strings.ToLower(tool), goos))
+
+	if err := src.extract(resp.Body, destPath, goos); err != nil {
+		return Entry{}, err
+	}
+
+	sum, err := sha256File(destPath)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	rel, err := filepath.Rel(cacheDir, destPath)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return Entry{Tool: tool, Platform: goos + "/" + goarch, Path: rel, SHA256: sum}, nil
+}
+
+// writeExecutable writes body to destPath as an executable file — the
+// extract step for tools that ship a bare binary (kubectl, k3d).
+func writeExecutable(body io.Reader, destPath, _ string) error {
+	out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", destPath, err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, body); err != nil {
+		return fmt.Errorf("failed to write %s: %w", destPath, err)
+	}
+	return nil
+}
+
+// extractHelmBinary pulls the helm binary out of Helm's release .tar.gz and
+// writes it to destPath — the extract step for Helm, which ships as an
+// archive rather than a bare binary.
+func extractHelmBinary(body io.Reader, destPath, goos string) error {
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("failed to open helm archive: %w", err)
+	}
+	defer gz.Close()
+
+	want := binaryName("helm", goos)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return fmt.Errorf("helm archive did not contain %s", want)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read helm archive: %w", err)
+		}
+		if filepath.Base(header.Name) != want {
+			continue
+		}
+
+		out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", destPath, err)
+		}
+		defer out.Close()
+
+		if _, err := io.Copy(out, tr); err != nil {
+			return fmt.Errorf("failed to write %s: %w", destPath, err)
+		}
+		return nil
+	}
+}
+
+// mirrorChartRepo clones repoURL as a bare mirror into the cache directory,
+// so an offline bootstrap can sync charts from it instead of github.com.
+func mirrorChartRepo(cacheDir, repoURL string) error {
+	dest := filepath.Join(cacheDir, chartRepoMirrorDir)
+	cmd := exec.Command("git", "clone", "--mirror", repoURL, dest)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git clone --mirror %s: %w", repoURL, err)
+	}
+	return nil
+}
+
+// binaryName returns tool's expected binary file name on goos.
+func binaryName(tool, goos string) string {
+	if goos == "windows" {
+		return tool + ".exe"
+	}
+	return tool
+}
+
+// sha256File hashes the file at path.
+func sha256File(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s for checksum: %w", path, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// splitPlatform parses a "GOOS/GOARCH" platform string.
+func splitPlatform(platform string) (goos, goarch string, err error) {
+	parts := strings.SplitN(platform, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid --platform %q: expected GOOS/GOARCH, e.g. linux/amd64", platform)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,119 @@
+// Package offlinecache builds and verifies the offline tool cache consumed
+// by `openframe bootstrap --offline`, so regulated environments can
+// bootstrap OpenFrame without reaching the network during preflight.
+package offlinecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ManifestFile is the name of the checksum manifest at the root of a cache
+// bundle.
+const ManifestFile = "manifest.json"
+
+// Entry records the expected checksum for a single cached artifact.
+type Entry struct {
+	Tool     string `json:"tool"`
+	Platform string `json:"platform"` // e.g. "linux/amd64"
+	Path     string `json:"path"`     // relative to the cache root
+	SHA256   string `json:"sha256"`
+}
+
+// Manifest is the full contents of manifest.json: every artifact a cache
+// bundle carries and the checksum it must match.
+type Manifest struct {
+	Entries []Entry `json:"entries"`
+}
+
+// LoadManifest reads manifest.json from the root of an unpacked cache bundle.
+func LoadManifest(cacheDir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(cacheDir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", ManifestFile, err)
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ManifestFile, err)
+	}
+	return &m, nil
+}
+
+// Find returns the manifest entry for tool on the given platform, or an
+// error if the cache bundle doesn't carry one.
+func (m *Manifest) Find(tool, platform string) (Entry, error) {
+	for _, e := range m.Entries {
+		if e.Tool == tool && e.Platform == platform {
+			return e, nil
+		}
+	}
+	return Entry{}, fmt.Errorf("no cached %s artifact for platform %s", tool, platform)
+}
+
+// VerifyChecksum hashes path and compares it against expectedSHA256.
+func VerifyChecksum(path, expectedSHA256 string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s for checksum verification: %w", path, err)
+	}
+
+	sum := sha256.Sum256(data)
+	actual := hex.EncodeToString(sum[:])
+	if actual != expectedSHA256 {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", path, expectedSHA256, actual)
+	}
+	return nil
+}
+
+// ResolveArtifact locates tool's cached binary for platform under cacheDir
+// and verifies its checksum against manifest.json. InstallBinary calls this
+// before copying the binary into place.
+func ResolveArtifact(cacheDir, tool, platform string) (string, error) {
+	manifest, err := LoadManifest(cacheDir)
+	if err != nil {
+		return "", err
+	}
+
+	entry, err := manifest.Find(tool, platform)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(cacheDir, entry.Path)
+	if err := VerifyChecksum(path, entry.SHA256); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// InstallBinary resolves tool's cached artifact for platform, verifies its
+// checksum, and copies it into destDir (created if needed) as an executable
+// file named after the artifact — the copy-into-place step every CLI-binary
+// tool's --offline install performs. Returns the installed path.
+func InstallBinary(cacheDir, tool, platform, destDir string) (string, error) {
+	src, err := ResolveArtifact(cacheDir, tool, platform)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", destDir, err)
+	}
+
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return "", fmt.Errorf("failed to read cached %s artifact: %w", tool, err)
+	}
+
+	dest := filepath.Join(destDir, filepath.Base(src))
+	if err := os.WriteFile(dest, data, 0o755); err != nil {
+		return "", fmt.Errorf("failed to install %s to %s: %w", tool, dest, err)
+	}
+
+	return dest, nil
+}
@@ -0,0 +1,86 @@
+// Package plugins discovers and loads site-specific preflight checks from
+// Go plugin (.so) files, so enterprise users can add gates such as VPN
+// reachability or internal CA presence without forking the CLI.
+package plugins
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"plugin"
+)
+
+// PreflightCheck is the interface a plugin's exported "NewCheck" symbol must
+// satisfy. It mirrors the checks built into bootstrap.PreflightChecker so
+// plugin-provided tools participate in the same install/report flow.
+type PreflightCheck interface {
+	Name() string
+	Category() string
+	IsInstalled() bool
+	Install() error
+	InstallHelp() string
+	SupportsPlatform(goos, goarch string) bool
+}
+
+// DefaultDir returns ~/.openframe/plugins, the location scanned for
+// preflight plugins when --plugin-dir is not set.
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".openframe", "plugins"), nil
+}
+
+// Load scans dir for *.so files, opens each as a Go plugin, and resolves its
+// exported `NewCheck() PreflightCheck` symbol. A missing directory is not an
+// error — it just means no plugins are installed. A plugin that fails to
+// open or doesn't match the expected symbol is skipped and reported back so
+// one bad plugin doesn't block the rest.
+func Load(dir string) ([]PreflightCheck, []error) {
+	var checks []PreflightCheck
+	var errs []error
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return checks, errs
+		}
+		return nil, []error{fmt.Errorf("failed to read plugin directory %s: %w", dir, err)}
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".so" {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		check, err := loadOne(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+			continue
+		}
+		checks = append(checks, check)
+	}
+
+	return checks, errs
+}
+
+func loadOne(path string) (PreflightCheck, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin: %w", err)
+	}
+
+	sym, err := p.Lookup("NewCheck")
+	if err != nil {
+		return nil, fmt.Errorf("missing NewCheck symbol: %w", err)
+	}
+
+	factory, ok := sym.(func() PreflightCheck)
+	if !ok {
+		return nil, fmt.Errorf("NewCheck has unexpected signature %T, want func() PreflightCheck", sym)
+	}
+
+	return factory(), nil
+}
@@ -2,13 +2,22 @@ package bootstrap
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/flamingo-stack/openframe-cli/configs"
+	"github.com/flamingo-stack/openframe-cli/internal/bootstrap/dag"
+	"github.com/flamingo-stack/openframe-cli/internal/bootstrap/offlinecache"
+	"github.com/flamingo-stack/openframe-cli/internal/bootstrap/plugins"
 	chartCerts "github.com/flamingo-stack/openframe-cli/internal/chart/prerequisites/certificates"
 	chartGit "github.com/flamingo-stack/openframe-cli/internal/chart/prerequisites/git"
 	chartHelm "github.com/flamingo-stack/openframe-cli/internal/chart/prerequisites/helm"
 	chartMemory "github.com/flamingo-stack/openframe-cli/internal/chart/prerequisites/memory"
+	"github.com/flamingo-stack/openframe-cli/internal/chart/prerequisites/versioncheck"
 	"github.com/flamingo-stack/openframe-cli/internal/cluster/prerequisites/docker"
 	"github.com/flamingo-stack/openframe-cli/internal/cluster/prerequisites/k3d"
 	"github.com/flamingo-stack/openframe-cli/internal/cluster/prerequisites/kubectl"
@@ -17,50 +26,188 @@ import (
 	"github.com/pterm/pterm"
 )
 
+// versionProbeTimeout bounds how long a `--version` probe command may run
+// before it is treated as "version unknown" rather than blocking preflight.
+const versionProbeTimeout = 5 * time.Second
+
 // PreflightChecker runs all prerequisite checks upfront before any work begins.
 // This unifies the cluster and chart prerequisite gates so users don't create a
 // cluster only to fail on chart prerequisites.
 type PreflightChecker struct {
-	nonInteractive bool
-	force          bool
-	verbose        bool
+	nonInteractive     bool
+	force              bool
+	verbose            bool
+	versionConstraints versioncheck.Registry
+	pluginChecks       []plugins.PreflightCheck
+	reportFormat       ReportFormat
+	reportPath         string
+	exitCode           int
+	kubeContextChecker *KubeContextChecker
+	reportMu           sync.Mutex // guards toolReports appends during concurrent install
+	eventMu            sync.Mutex // guards NDJSON event writes during concurrent detect/install
+	offline            bool
+	cacheDir           string
 }
 
-// NewPreflightChecker creates a new unified preflight checker.
+// NewPreflightChecker creates a new unified preflight checker. Tool version
+// constraints are loaded from the constraints table embedded in the binary;
+// call LoadConstraintsFile to override them with a site-local file.
 func NewPreflightChecker(nonInteractive, force, verbose bool) *PreflightChecker {
-	return &PreflightChecker{
+	p := &PreflightChecker{
 		nonInteractive: nonInteractive,
 		force:          force,
 		verbose:        verbose,
+		reportFormat:   ReportFormatTable,
+	}
+
+	reg, err := versioncheck.LoadRegistryBytes(configs.PrerequisitesTOML)
+	if err != nil {
+		pterm.Debug.Printf("failed to load embedded prerequisite constraints: %v\n", err)
+		reg = versioncheck.Registry{}
+	}
+	p.versionConstraints = reg
+
+	return p
+}
+
+// LoadConstraintsFile overrides the embedded default version constraints
+// with one loaded from disk, e.g. to pin tool versions per OpenFrame
+// release without rebuilding the binary.
+func (p *PreflightChecker) LoadConstraintsFile(path string) error {
+	reg, err := versioncheck.LoadRegistry(path)
+	if err != nil {
+		return err
 	}
+	p.versionConstraints = reg
+	return nil
+}
+
+// SetReportOptions configures how CheckAll renders progress and where it
+// writes the final aggregated report. An empty reportPath skips writing a
+// report file; format defaults to ReportFormatTable if unset.
+func (p *PreflightChecker) SetReportOptions(format ReportFormat, reportPath string) {
+	if format == "" {
+		format = ReportFormatTable
+	}
+	p.reportFormat = format
+	p.reportPath = reportPath
+}
+
+// ExitCode returns the stable exit code for the most recent CheckAll run,
+// following the convention documented on the Exit* constants.
+func (p *PreflightChecker) ExitCode() int {
+	return p.exitCode
+}
+
+// SetOffline switches CheckAll to install missing tools from a pre-populated
+// cache instead of the network — for air-gapped environments, paired with a
+// bundle produced by `openframe bootstrap package`. cacheDir must be the
+// root of an unpacked bundle (the directory containing manifest.json).
+func (p *PreflightChecker) SetOffline(offline bool, cacheDir string) {
+	p.offline = offline
+	p.cacheDir = cacheDir
+}
+
+// EnableKubeContextCheck registers the kube-context preflight gate. Registering
+// checks nothing by itself and CheckAll never runs it; the gate runs only when
+// CheckKubeContext is called, once the new cluster's context exists in the
+// kubeconfig.
+func (p *PreflightChecker) EnableKubeContextCheck(kubeconfigFlag, contextFlag string) {
+	p.kubeContextChecker = NewKubeContextChecker(kubeconfigFlag, contextFlag, p.nonInteractive)
+}
+
+// RegisterCheck adds a site-specific preflight check — typically one loaded
+// from a plugin via LoadPlugins — so it participates in CheckAll alongside
+// the built-in tools.
+func (p *PreflightChecker) RegisterCheck(check plugins.PreflightCheck) {
+	p.pluginChecks = append(p.pluginChecks, check)
+}
+
+// LoadPlugins scans dir for preflight check plugins and registers every one
+// that supports the current platform. Plugins that fail to load are
+// reported back rather than aborting preflight, since a broken plugin
+// shouldn't block a user who doesn't need it.
+func (p *PreflightChecker) LoadPlugins(dir string) []error {
+	checks, errs := plugins.Load(dir)
+	for _, check := range checks {
+		if !check.SupportsPlatform(runtime.GOOS, runtime.GOARCH) {
+			continue
+		}
+		p.RegisterCheck(check)
+	}
+	return errs
 }
 
 // preflightTool represents a tool to check during preflight.
 type preflightTool struct {
-	Name        string
-	Category    string // "cluster" or "chart"
-	IsInstalled func() bool
-	InstallHelp func() string
-	Installable bool // false for things like memory
+	Name          string
+	Category      string   // "cluster" or "chart"
+	DependsOn     []string // other tool Names that must be installed first, e.g. k3d depends on Docker
+	IsInstalled   func() bool
+	InstallHelp   func() string
+	Installable   bool                                  // false for things like memory
+	DetectVersion func() (versioncheck.Version, error) // nil if the tool has no enforced version range
+	Install       func() error                          // nil for built-in tools, which install via the switch in installTool
+}
+
+// versionWarning records a tool whose detected version falls outside its
+// constraint but was allowed to proceed because --force was passed.
+type versionWarning struct {
+	Tool       string
+	Detected   versioncheck.Version
+	Constraint versioncheck.Constraint
 }
 
 // CheckAll runs all prerequisite checks and installs missing tools.
 // It checks cluster prerequisites (Docker, kubectl, k3d, helm) and chart
 // prerequisites (git, helm, memory, certificates) in a single pass.
+//
+// When SetReportOptions has selected ReportFormatJSON, pterm spinners/tables
+// are suppressed; CheckAll instead streams NDJSON progress events to stdout
+// and, if a report path was set, writes a final PreflightReport there. The
+// memory check and the Docker start step still print through pterm (including
+// the Docker start spinner) in JSON mode. In either mode, ExitCode() reports a
+// stable exit class after CheckAll returns.
 func (p *PreflightChecker) CheckAll() error {
+	p.exitCode = ExitSuccess
+	var toolReports []ToolReport
+	defer p.writeReportFile(&toolReports)
+
+	// Phase 0: Reject platforms none of the built-in installers target
+	// before probing for tools that can't exist there.
+	if !supportedPlatform(runtime.GOOS) {
+		p.exitCode = ExitUnsupportedPlatform
+		return fmt.Errorf("unsupported platform %s/%s: openframe bootstrap supports darwin, linux, and windows", runtime.GOOS, runtime.GOARCH)
+	}
+
 	// Phase 1: Check memory upfront — fail fast if insufficient
 	if err := p.checkMemory(); err != nil {
+		p.exitCode = ExitInsufficientMemory
 		return err
 	}
 
-	// Phase 2: Check all tools
+	// Phase 2: Check all tools concurrently (a worker pool sized to
+	// runtime.NumCPU(), since each IsInstalled/DetectVersion call shells
+	// out), including their versions against configs/prerequisites.toml
+	// where a constraint is declared.
 	tools := p.getAllTools()
-	var missing []preflightTool
+	detections := p.detectAll(tools)
 
-	for _, tool := range tools {
-		if !tool.IsInstalled() {
+	var missing []preflightTool
+	var outOfRange []versionWarning
+	for i, tool := range tools {
+		d := detections[i]
+		toolReports = append(toolReports, d.report)
+		if d.missing {
 			missing = append(missing, tool)
 		}
+		if d.outOfRange != nil {
+			outOfRange = append(outOfRange, *d.outOfRange)
+		}
+	}
+
+	if len(outOfRange) > 0 && p.reportFormat != ReportFormatJSON {
+		p.showVersionWarnings(outOfRange)
 	}
 
 	if len(missing) == 0 {
@@ -85,35 +232,49 @@ func (p *PreflightChecker) CheckAll() error {
 
 	// Phase 3: Install missing tools
 	if len(installable) > 0 {
-		names := make([]string, len(installable))
-		for i, t := range installable {
-			names[i] = t.Name
+		if p.reportFormat != ReportFormatJSON {
+			names := make([]string, len(installable))
+			for i, t := range installable {
+				names[i] = t.Name
+			}
+			pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(names, ", "))
 		}
-		pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(names, ", "))
 
 		var confirmed bool
 		if p.nonInteractive {
-			pterm.Info.Println("Auto-installing prerequisites (non-interactive mode)...")
+			if p.reportFormat != ReportFormatJSON {
+				pterm.Info.Println("Auto-installing prerequisites (non-interactive mode)...")
+			}
 			confirmed = true
 		} else {
 			var err error
 			confirmed, err = ui.ConfirmActionInteractive("Would you like me to install them automatically?", true)
 			if err := sharedErrors.WrapConfirmationError(err, "failed to get user confirmation"); err != nil {
+				p.exitCode = ExitInstallFailed
 				return err
 			}
 		}
 
 		if confirmed {
-			if err := p.installTools(installable); err != nil {
+			if err := p.installTools(installable, &toolReports); err != nil {
 				if p.nonInteractive {
-					pterm.Warning.Printf("Failed to install some prerequisites: %v\n", err)
-					pterm.Info.Println("Continuing anyway (non-interactive mode)...")
+					if p.reportFormat != ReportFormatJSON {
+						pterm.Warning.Printf("Failed to install some prerequisites: %v\n", err)
+						pterm.Info.Println("Continuing anyway (non-interactive mode)...")
+					}
+					// Still surface the failure in the exit code/report so CI
+					// doesn't read a green 0 for a run that failed installs.
+					p.exitCode = ExitInstallFailed
 				} else {
+					p.exitCode = ExitInstallFailed
 					return err
 				}
 			}
 		} else {
-			p.showManualInstructions(installable)
+			if p.reportFormat != ReportFormatJSON {
+				p.showManualInstructions(installable)
+			}
+			p.exitCode = ExitUserDeclined
 			return fmt.Errorf("prerequisites not installed")
 		}
 	}
@@ -121,6 +282,7 @@ func (p *PreflightChecker) CheckAll() error {
 	// Phase 4: Start Docker if needed
 	if dockerNotRunning {
 		if err := p.startDocker(); err != nil {
+			p.exitCode = ExitInstallFailed
 			return err
 		}
 	}
@@ -128,6 +290,30 @@ func (p *PreflightChecker) CheckAll() error {
 	return nil
 }
 
+// writeReportFile writes the aggregated PreflightReport to p.reportPath, if
+// one was configured via SetReportOptions. Failures are logged but never
+// override the exit code CheckAll already determined.
+func (p *PreflightChecker) writeReportFile(toolReports *[]ToolReport) {
+	if p.reportPath == "" {
+		return
+	}
+	report := PreflightReport{Tools: *toolReports, ExitCode: p.exitCode}
+	if err := WriteReport(p.reportPath, report); err != nil {
+		pterm.Warning.Printf("Failed to write preflight report: %v\n", err)
+	}
+}
+
+// supportedPlatform reports whether goos is one of the platforms OpenFrame's
+// installers and startDocker/showDockerStartInstructions know how to target.
+func supportedPlatform(goos string) bool {
+	switch goos {
+	case "darwin", "linux", "windows":
+		return true
+	default:
+		return false
+	}
+}
+
 // checkMemory validates system memory against the recommended minimum.
 func (p *PreflightChecker) checkMemory() error {
 	memChecker := chartMemory.NewMemoryChecker()
@@ -155,8 +341,192 @@ func (p *PreflightChecker) checkMemory() error {
 	return nil
 }
 
-// getAllTools returns all prerequisite tools for both cluster and chart phases.
+// toolDetection is one tool's outcome from detectAll.
+type toolDetection struct {
+	report     ToolReport
+	missing    bool
+	outOfRange *versionWarning
+}
+
+// detectAll runs IsInstalled/checkVersion for every tool concurrently,
+// bounded by a worker pool sized to runtime.NumCPU() since each check
+// typically shells out to the tool's binary. Results are returned in the
+// same order as tools so callers can zip them back together.
+func (p *PreflightChecker) detectAll(tools []preflightTool) []toolDetection {
+	results := make([]toolDetection, len(tools))
+
+	workers := runtime.NumCPU()
+	if workers < 1 {
+		workers = 1
+	}
+	sem := make(chan struct{}, workers)
+
+	var wg sync.WaitGroup
+	wg.Add(len(tools))
+	for i, tool := range tools {
+		i, tool := i, tool
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = p.detectOne(tool)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// detectOne runs a single tool's IsInstalled/checkVersion probe and builds
+// its ToolReport entry.
+func (p *PreflightChecker) detectOne(tool preflightTool) toolDetection {
+	start := time.Now()
+	p.emitEvent("check.start", tool.Name, nil)
+	defer p.emitEvent("check.end", tool.Name, nil)
+
+	report := ToolReport{Name: tool.Name, Category: tool.Category, Action: "none"}
+	if constraint, ok := p.versionConstraints[tool.Name]; ok {
+		report.RequiredVersion = requiredRange(constraint)
+	}
+
+	if !tool.IsInstalled() {
+		report.Installed = false
+		report.Duration = time.Since(start)
+		return toolDetection{report: report, missing: true}
+	}
+
+	report.Installed = true
+	d := toolDetection{}
+	ok, warning := p.checkVersion(tool)
+	if warning.Tool != "" {
+		report.DetectedVersion = warning.Detected.String()
+	}
+	if !ok {
+		if p.force {
+			d.outOfRange = &warning
+		} else {
+			// Routed to installTools for an in-place upgrade below, so the
+			// report shouldn't claim nothing is happening to it.
+			report.Action = "upgrade-pending"
+			d.missing = true
+		}
+	}
+
+	report.Duration = time.Since(start)
+	d.report = report
+	return d
+}
+
+// checkVersion detects the installed version of a tool (if it declares a
+// DetectVersion probe and a constraint exists for it) and reports whether it
+// satisfies configs/prerequisites.toml. Tools without a probe or constraint,
+// or whose version can't be detected, are treated as satisfying by default —
+// version enforcement should never block a tool preflight already trusted
+// via IsInstalled.
+func (p *PreflightChecker) checkVersion(tool preflightTool) (bool, versionWarning) {
+	if tool.DetectVersion == nil {
+		return true, versionWarning{}
+	}
+
+	constraint, ok := p.versionConstraints[tool.Name]
+	if !ok {
+		return true, versionWarning{}
+	}
+
+	detected, err := tool.DetectVersion()
+	if err != nil {
+		if p.verbose {
+			pterm.Debug.Printf("could not detect %s version: %v\n", tool.Name, err)
+		}
+		return true, versionWarning{}
+	}
+
+	// Carried on the success path too, so detectOne can record
+	// DetectedVersion for in-range tools instead of only out-of-range ones.
+	warning := versionWarning{Tool: tool.Name, Detected: detected, Constraint: constraint}
+
+	satisfies, err := constraint.Satisfies(detected)
+	if err != nil {
+		if p.verbose {
+			pterm.Debug.Printf("skipping version check for %s: %v\n", tool.Name, err)
+		}
+		return true, warning
+	}
+	if satisfies {
+		return true, warning
+	}
+
+	return false, warning
+}
+
+// showVersionWarnings renders the tools that were allowed through preflight
+// with an out-of-range version because --force was specified.
+func (p *PreflightChecker) showVersionWarnings(warnings []versionWarning) {
+	fmt.Println()
+	pterm.Warning.Println("Continuing with out-of-range tool versions (--force specified):")
+
+	tableData := pterm.TableData{{"Tool", "Detected", "Required"}}
+	for _, w := range warnings {
+		tableData = append(tableData, []string{pterm.Cyan(w.Tool), w.Detected.String(), requiredRange(w.Constraint)})
+	}
+	pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
+
+// requiredRange renders a version constraint as a human-readable range.
+func requiredRange(c versioncheck.Constraint) string {
+	switch {
+	case c.Min != "" && c.Max != "":
+		return fmt.Sprintf(">= %s, <= %s", c.Min, c.Max)
+	case c.Min != "":
+		return fmt.Sprintf(">= %s", c.Min)
+	case c.Max != "":
+		return fmt.Sprintf("<= %s", c.Max)
+	default:
+		return "any"
+	}
+}
+
+// getAllTools returns all prerequisite tools for both cluster and chart
+// phases, plus any site-specific tools registered via RegisterCheck/LoadPlugins.
 func (p *PreflightChecker) getAllTools() []preflightTool {
+	tools := p.builtinTools()
+
+	for _, check := range p.pluginChecks {
+		check := check // capture for closures below
+		tools = append(tools, preflightTool{
+			Name:        check.Name(),
+			Category:    check.Category(),
+			IsInstalled: check.IsInstalled,
+			InstallHelp: check.InstallHelp,
+			Installable: true,
+			Install:     check.Install,
+		})
+	}
+
+	return tools
+}
+
+// CheckKubeContext runs the kube-context gate registered via
+// EnableKubeContextCheck. Call it explicitly — after cluster creation and
+// before chart install — rather than folding it into CheckAll: it can
+// prompt interactively via pterm.DefaultInteractiveSelect, and that must
+// not race the concurrent dag.Run/pterm.DefaultMultiPrinter install phase
+// CheckAll drives for the other tools. A no-op if EnableKubeContextCheck
+// was never called.
+func (p *PreflightChecker) CheckKubeContext() error {
+	if p.kubeContextChecker == nil {
+		return nil
+	}
+	if err := p.kubeContextChecker.Check(); err != nil {
+		p.exitCode = ExitInstallFailed
+		return fmt.Errorf("%w\n%s", err, p.kubeContextChecker.InstallHelp())
+	}
+	return nil
+}
+
+// builtinTools returns the tools OpenFrame ships preflight checks for out of
+// the box.
+func (p *PreflightChecker) builtinTools() []preflightTool {
 	return []preflightTool{
 		// Cluster prerequisites
 		{
@@ -165,6 +535,9 @@ func (p *PreflightChecker) getAllTools() []preflightTool {
 			IsInstalled: func() bool { return docker.IsDockerRunning() },
 			InstallHelp: func() string { return docker.NewDockerInstaller().GetInstallHelp() },
 			Installable: true,
+			DetectVersion: func() (versioncheck.Version, error) {
+				return versioncheck.DetectCommand(versionProbeTimeout, "docker", "--version")
+			},
 		},
 		{
 			Name:        "kubectl",
@@ -172,13 +545,20 @@ func (p *PreflightChecker) getAllTools() []preflightTool {
 			IsInstalled: func() bool { return kubectl.NewKubectlInstaller().IsInstalled() },
 			InstallHelp: func() string { return kubectl.NewKubectlInstaller().GetInstallHelp() },
 			Installable: true,
+			DetectVersion: func() (versioncheck.Version, error) {
+				return versioncheck.DetectCommand(versionProbeTimeout, "kubectl", "version", "--client")
+			},
 		},
 		{
 			Name:        "k3d",
 			Category:    "cluster",
+			DependsOn:   []string{"Docker"}, // k3d creates its cluster nodes as Docker containers
 			IsInstalled: func() bool { return k3d.NewK3dInstaller().IsInstalled() },
 			InstallHelp: func() string { return k3d.NewK3dInstaller().GetInstallHelp() },
 			Installable: true,
+			DetectVersion: func() (versioncheck.Version, error) {
+				return versioncheck.DetectCommand(versionProbeTimeout, "k3d", "version")
+			},
 		},
 		{
 			Name:        "Helm",
@@ -186,6 +566,9 @@ func (p *PreflightChecker) getAllTools() []preflightTool {
 			IsInstalled: func() bool { return chartHelm.NewHelmInstaller().IsInstalled() },
 			InstallHelp: func() string { return chartHelm.NewHelmInstaller().GetInstallHelp() },
 			Installable: true,
+			DetectVersion: func() (versioncheck.Version, error) {
+				return versioncheck.DetectCommand(versionProbeTimeout, "helm", "version", "--short")
+			},
 		},
 		// Chart prerequisites (excluding memory — handled separately)
 		{
@@ -205,27 +588,175 @@ func (p *PreflightChecker) getAllTools() []preflightTool {
 	}
 }
 
-// installTools installs the given list of missing tools.
-func (p *PreflightChecker) installTools(tools []preflightTool) error {
-	for idx, tool := range tools {
-		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("[%d/%d] Installing %s...", idx+1, len(tools), tool.Name))
+// installTools installs the given list of missing tools in topological
+// order: independent tools (and independent branches of the dependency
+// graph, e.g. Helm alongside Docker+k3d) install in parallel via dag.Run,
+// while a dependent like k3d waits for Docker to finish first. Progress
+// renders as one simultaneous spinner per tool via pterm.DefaultMultiPrinter
+// instead of a sequential "[i/n]" spinner.
+func (p *PreflightChecker) installTools(tools []preflightTool, toolReports *[]ToolReport) error {
+	if len(tools) == 0 {
+		return nil
+	}
+
+	names := make(map[string]bool, len(tools))
+	for _, t := range tools {
+		names[t.Name] = true
+	}
+
+	var multi pterm.MultiPrinter
+	spinners := make(map[string]*pterm.SpinnerPrinter, len(tools))
+	if p.reportFormat != ReportFormatJSON {
+		multi = pterm.DefaultMultiPrinter
+		multi.Start()
+		defer multi.Stop()
+		for _, tool := range tools {
+			spinner, _ := pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start(fmt.Sprintf("Installing %s...", tool.Name))
+			spinners[tool.Name] = spinner
+		}
+	}
+
+	var failedErr error
+	var mu sync.Mutex
 
-		if err := p.installTool(tool); err != nil {
-			if p.nonInteractive {
-				spinner.Warning(fmt.Sprintf("Skipped %s: %v", tool.Name, err))
-				continue
+	nodes := make([]dag.Node, len(tools))
+	for i, tool := range tools {
+		tool := tool
+
+		// Only depend on tools that are actually part of this install
+		// batch — a dependency that's already installed won't appear here.
+		var dependsOn []string
+		for _, dep := range tool.DependsOn {
+			if names[dep] {
+				dependsOn = append(dependsOn, dep)
 			}
-			spinner.Fail(fmt.Sprintf("Failed to install %s: %v", tool.Name, err))
-			return fmt.Errorf("failed to install %s: %w", tool.Name, err)
 		}
 
-		spinner.Success(fmt.Sprintf("%s installed successfully", tool.Name))
+		nodes[i] = dag.Node{
+			Name:      tool.Name,
+			DependsOn: dependsOn,
+			Run: func() error {
+				p.emitEvent("install.start", tool.Name, nil)
+				start := time.Now()
+				err := p.installTool(tool)
+				p.recordInstallOutcome(toolReports, tool.Name, time.Since(start), err)
+				p.emitEvent("install.end", tool.Name, err)
+
+				if spinner, ok := spinners[tool.Name]; ok {
+					switch {
+					case err != nil && p.nonInteractive:
+						spinner.Warning(fmt.Sprintf("Skipped %s: %v", tool.Name, err))
+					case err != nil:
+						spinner.Fail(fmt.Sprintf("Failed to install %s: %v", tool.Name, err))
+					default:
+						spinner.Success(fmt.Sprintf("%s installed successfully", tool.Name))
+					}
+				}
+
+				if err != nil {
+					mu.Lock()
+					if failedErr == nil {
+						failedErr = fmt.Errorf("failed to install %s: %w", tool.Name, err)
+					}
+					mu.Unlock()
+				}
+				return err
+			},
+		}
 	}
-	return nil
+
+	results, err := dag.Run(nodes)
+	if err != nil {
+		return err
+	}
+
+	// dag.Run never calls Run for a node skipped because one of its
+	// dependencies failed, so the spinner-resolution and report-recording
+	// inside each node's Run closure above never executes for it either.
+	// Resolve those here so nothing is left spinning once multi.Stop() runs.
+	for _, tool := range tools {
+		res := results[tool.Name]
+		if res == nil || !strings.HasPrefix(res.Error(), "skipped:") {
+			continue // either succeeded, or failed inside its own Run() and was already resolved there
+		}
+
+		p.emitEvent("install.end", tool.Name, res)
+		p.recordSkippedOutcome(toolReports, tool.Name, res)
+
+		if spinner, ok := spinners[tool.Name]; ok {
+			spinner.Warning(fmt.Sprintf("Skipped %s: %v", tool.Name, res))
+		}
+	}
+
+	return failedErr
+}
+
+// recordSkippedOutcome records a tool that dag.Run skipped because one of
+// its dependencies failed, mirroring recordInstallOutcome's upsert so a
+// skipped tool still gets a terminal entry in the report instead of none.
+func (p *PreflightChecker) recordSkippedOutcome(toolReports *[]ToolReport, name string, err error) {
+	p.reportMu.Lock()
+	defer p.reportMu.Unlock()
+
+	for i := range *toolReports {
+		if (*toolReports)[i].Name != name {
+			continue
+		}
+		(*toolReports)[i].Action = "skipped"
+		(*toolReports)[i].Error = err.Error()
+		return
+	}
+
+	*toolReports = append(*toolReports, ToolReport{Name: name, Action: "skipped", Error: err.Error()})
 }
 
-// installTool installs a single tool by name.
+// recordInstallOutcome updates the ToolReport matching name (appending one
+// if the tool wasn't already recorded) with the result of an install attempt. Installs run concurrently across
+// independent DAG branches, so this locks reportMu around the shared slice.
+func (p *PreflightChecker) recordInstallOutcome(toolReports *[]ToolReport, name string, duration time.Duration, err error) {
+	p.reportMu.Lock()
+	defer p.reportMu.Unlock()
+
+	for i := range *toolReports {
+		if (*toolReports)[i].Name != name {
+			continue
+		}
+		if err != nil {
+			(*toolReports)[i].Action = "failed"
+			(*toolReports)[i].Error = err.Error()
+		} else {
+			(*toolReports)[i].Action = "installed"
+			(*toolReports)[i].Installed = true
+		}
+		(*toolReports)[i].Duration += duration
+		return
+	}
+
+	report := ToolReport{Name: name, Action: "installed", Installed: err == nil, Duration: duration}
+	if err != nil {
+		report.Action = "failed"
+		report.Error = err.Error()
+	}
+	*toolReports = append(*toolReports, report)
+}
+
+// installTool installs a single tool by name. Plugin-provided tools carry
+// their own Install func and bypass the built-in switch entirely.
+//
+// Every branch here, and every plugin-supplied Install, must upgrade an
+// already-present tool in place rather than no-op: detectOne routes an
+// installed-but-out-of-range tool here (Action "upgrade-pending") the same
+// way it routes a missing one, relying on Install to bring it back into the
+// constraint's range.
 func (p *PreflightChecker) installTool(tool preflightTool) error {
+	if tool.Install != nil {
+		return tool.Install()
+	}
+
+	if p.offline {
+		return p.installToolFromCache(tool)
+	}
+
 	switch tool.Name {
 	case "Docker":
 		return docker.NewDockerInstaller().Install()
@@ -248,6 +779,64 @@ func (p *PreflightChecker) installTool(tool preflightTool) error {
 	}
 }
 
+// installToolFromCache installs a tool from p.cacheDir instead of the
+// network, for --offline bootstrap. kubectl/k3d/Helm are plain CLI
+// binaries, so offlinecache.InstallBinary verifies the cached artifact's
+// checksum and copies it into offlineBinDir(), which is then prepended to
+// PATH for the rest of this run so the IsInstalled/DetectVersion probes
+// below see it immediately. Docker (a daemon, not a binary the cache can
+// carry), Git, and Certificates (interactive generation) aren't satisfiable
+// from the cache and remain manual even offline.
+func (p *PreflightChecker) installToolFromCache(tool preflightTool) error {
+	switch tool.Name {
+	case "Git":
+		return fmt.Errorf("git must be installed manually, even in --offline mode: %s", chartGit.NewGitChecker().GetInstallInstructions())
+	case "Docker":
+		return fmt.Errorf("Docker must be installed manually, even in --offline mode: the cache only carries CLI binaries, not the Docker engine. %s", docker.NewDockerInstaller().GetInstallHelp())
+	case "Certificates":
+		if p.nonInteractive {
+			pterm.Info.Println("Skipping certificate generation in non-interactive mode")
+			return nil
+		}
+		return fmt.Errorf("certificate generation isn't available offline yet; generate them manually. %s", chartCerts.NewCertificateInstaller().GetInstallHelp())
+	}
+
+	destDir, err := offlineBinDir()
+	if err != nil {
+		return fmt.Errorf("offline cache for %s: %w", tool.Name, err)
+	}
+
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	installed, err := offlinecache.InstallBinary(p.cacheDir, tool.Name, platform, destDir)
+	if err != nil {
+		return fmt.Errorf("offline cache for %s: %w", tool.Name, err)
+	}
+
+	if err := prependPath(destDir); err != nil {
+		return fmt.Errorf("installed %s to %s but failed to add it to PATH: %w", tool.Name, installed, err)
+	}
+
+	return nil
+}
+
+// offlineBinDir is where installToolFromCache copies binaries it installs
+// from the offline cache, alongside the rest of OpenFrame's per-user state
+// (see plugins.DefaultDir's ~/.openframe/plugins).
+func offlineBinDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".openframe", "bin"), nil
+}
+
+// prependPath puts dir first on PATH for the current process, so a tool
+// installToolFromCache just installed there is found without the user
+// needing to restart their shell.
+func prependPath(dir string) error {
+	return os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
 // startDocker attempts to start Docker.
 func (p *PreflightChecker) startDocker() error {
 	if p.nonInteractive {
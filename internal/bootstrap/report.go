@@ -0,0 +1,91 @@
+package bootstrap
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ReportFormat selects how CheckAll renders its progress and final summary.
+type ReportFormat string
+
+const (
+	// ReportFormatTable is the default pterm spinner/table UI.
+	ReportFormatTable ReportFormat = "table"
+	// ReportFormatJSON suppresses pterm output in favor of NDJSON events on
+	// stdout, so CI systems can consume preflight results without scraping
+	// human-readable text.
+	ReportFormatJSON ReportFormat = "json"
+)
+
+// Exit codes for CheckAll failures, mirroring Docker CLI's StatusError
+// pattern so pipelines can branch on failure class rather than parse text.
+const (
+	ExitSuccess             = 0
+	ExitUserDeclined        = 2
+	ExitUnsupportedPlatform = 3
+	ExitInsufficientMemory  = 4
+	ExitInstallFailed       = 5
+)
+
+// ToolReport is the machine-readable outcome of a single preflight tool check.
+type ToolReport struct {
+	Name            string        `json:"name"`
+	Category        string        `json:"category"`
+	DetectedVersion string        `json:"detected_version,omitempty"`
+	RequiredVersion string        `json:"required_version,omitempty"`
+	Installed       bool          `json:"installed"`
+	Action          string        `json:"action"` // "none", "upgrade-pending", "installed", "skipped", "failed"
+	Duration        time.Duration `json:"duration_ns"`
+	Error           string        `json:"error,omitempty"`
+}
+
+// PreflightReport is the aggregated result of a CheckAll run, written to
+// --report when requested.
+type PreflightReport struct {
+	Tools    []ToolReport `json:"tools"`
+	ExitCode int          `json:"exit_code"`
+}
+
+// reportEvent is a single NDJSON line streamed to stdout in JSON output mode.
+type reportEvent struct {
+	Event string `json:"event"`
+	Tool  string `json:"tool,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// emitEvent streams a single NDJSON progress event to stdout. It is a no-op
+// outside ReportFormatJSON. detectAll and installTools both call this from a
+// worker pool, so writes are serialized to keep the NDJSON stream from
+// interleaving into corrupt lines.
+func (p *PreflightChecker) emitEvent(event, tool string, err error) {
+	if p.reportFormat != ReportFormatJSON {
+		return
+	}
+
+	e := reportEvent{Event: event, Tool: tool}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	data, marshalErr := json.Marshal(e)
+	if marshalErr != nil {
+		return
+	}
+
+	p.eventMu.Lock()
+	defer p.eventMu.Unlock()
+	fmt.Println(string(data))
+}
+
+// WriteReport writes the aggregated report as indented JSON to path.
+func WriteReport(path string, report PreflightReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal preflight report: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write preflight report to %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Package kubecontext resolves the kubeconfig and cluster context that a
+// subsequent `openframe chart install` will target, and verifies the
+// cluster is reachable and the current identity holds the RBAC the ArgoCD
+// install needs.
+package kubecontext
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// apiTimeout bounds how long the live API reachability probe may run.
+const apiTimeout = 5 * time.Second
+
+// requiredRules are the RBAC verb/resource pairs ArgoCD install needs on the
+// target cluster, checked via `kubectl auth can-i`.
+var requiredRules = []struct {
+	Verb     string
+	Resource string
+}{
+	{"create", "namespaces"},
+	{"create", "customresourcedefinitions"},
+	{"create", "clusterrolebindings"},
+}
+
+// Config is the effective kubeconfig path and context that chart install
+// will target.
+type Config struct {
+	Path    string
+	Context string
+}
+
+// Resolve determines the effective kubeconfig path from, in priority order,
+// kubeconfigFlag, the KUBECONFIG env var, and $HOME/.kube/config; and the
+// effective context from contextFlag or the kubeconfig's current-context.
+func Resolve(kubeconfigFlag, contextFlag string) (Config, error) {
+	path := kubeconfigFlag
+	if path == "" {
+		path = os.Getenv("KUBECONFIG")
+	}
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to resolve home directory: %w", err)
+		}
+		path = filepath.Join(home, ".kube", "config")
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return Config{}, fmt.Errorf("kubeconfig not found at %s: %w", path, err)
+	}
+
+	ctxName := contextFlag
+	if ctxName == "" {
+		current, err := runKubectl(path, "", "config", "current-context")
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to resolve current kubeconfig context: %w", err)
+		}
+		ctxName = strings.TrimSpace(current)
+	}
+
+	return Config{Path: path, Context: ctxName}, nil
+}
+
+// Contexts lists every context name defined in the kubeconfig.
+func (c Config) Contexts() ([]string, error) {
+	out, err := runKubectl(c.Path, "", "config", "get-contexts", "-o", "name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kubeconfig contexts: %w", err)
+	}
+
+	var contexts []string
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			contexts = append(contexts, line)
+		}
+	}
+	return contexts, nil
+}
+
+// CheckReachable performs a live API server check against the configured
+// context, bounded by apiTimeout.
+func (c Config) CheckReachable() error {
+	ctx, cancel := context.WithTimeout(context.Background(), apiTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "kubectl", "--kubeconfig", c.Path, "--context", c.Context, "version", "--output=yaml")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("cluster %q is not reachable: %w (%s)", c.Context, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// CheckRBAC verifies the current identity can perform the verbs ArgoCD
+// install needs, via `kubectl auth can-i` SelfSubjectAccessReviews.
+func (c Config) CheckRBAC() error {
+	var denied []string
+	for _, rule := range requiredRules {
+		out, err := runKubectl(c.Path, c.Context, "auth", "can-i", rule.Verb, rule.Resource)
+		if err != nil || strings.TrimSpace(out) != "yes" {
+			denied = append(denied, fmt.Sprintf("%s %s", rule.Verb, rule.Resource))
+		}
+	}
+	if len(denied) > 0 {
+		return fmt.Errorf("missing RBAC permissions for ArgoCD install: %s", strings.Join(denied, ", "))
+	}
+	return nil
+}
+
+func runKubectl(kubeconfig, kubeContext string, args ...string) (string, error) {
+	cmdArgs := []string{"--kubeconfig", kubeconfig}
+	if kubeContext != "" {
+		cmdArgs = append(cmdArgs, "--context", kubeContext)
+	}
+	cmdArgs = append(cmdArgs, args...)
+
+	out, err := exec.Command("kubectl", cmdArgs...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("kubectl %s: %w", strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,61 @@
+package versioncheck
+
+import (
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Constraint declares the acceptable version range for a single tool.
+// Either bound may be left empty to mean "unconstrained on that side".
+type Constraint struct {
+	Min string `toml:"min_version"`
+	Max string `toml:"max_version"`
+}
+
+// Satisfies reports whether v falls within the constraint's [Min, Max] range.
+func (c Constraint) Satisfies(v Version) (bool, error) {
+	if c.Min != "" {
+		min, err := ParseVersion(c.Min)
+		if err != nil {
+			return false, fmt.Errorf("invalid min_version %q: %w", c.Min, err)
+		}
+		if v.Compare(min) < 0 {
+			return false, nil
+		}
+	}
+	if c.Max != "" {
+		max, err := ParseVersion(c.Max)
+		if err != nil {
+			return false, fmt.Errorf("invalid max_version %q: %w", c.Max, err)
+		}
+		if v.Compare(max) > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Registry maps a tool name (as used by PreflightChecker.getAllTools) to its
+// version constraint for the current OpenFrame release.
+type Registry map[string]Constraint
+
+// LoadRegistry reads tool version constraints from a TOML file on disk, such
+// as an operator-supplied override of the embedded defaults.
+func LoadRegistry(path string) (Registry, error) {
+	var reg Registry
+	if _, err := toml.DecodeFile(path, &reg); err != nil {
+		return nil, fmt.Errorf("failed to load prerequisite constraints from %s: %w", path, err)
+	}
+	return reg, nil
+}
+
+// LoadRegistryBytes reads tool version constraints from an in-memory TOML
+// document, such as the table embedded into the binary at build time.
+func LoadRegistryBytes(data []byte) (Registry, error) {
+	var reg Registry
+	if _, err := toml.Decode(string(data), &reg); err != nil {
+		return nil, fmt.Errorf("failed to parse prerequisite constraints: %w", err)
+	}
+	return reg, nil
+}
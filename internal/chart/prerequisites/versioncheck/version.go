@@ -0,0 +1,77 @@
+// Package versioncheck parses CLI tool version output and evaluates it
+// against minimum/maximum version constraints declared for a release.
+package versioncheck
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// versionPattern matches a semantic version, with or without a leading "v",
+// inside arbitrary `--version` output (e.g. "Docker version 24.0.6, build ed223bc").
+var versionPattern = regexp.MustCompile(`v?(\d+)\.(\d+)\.(\d+)`)
+
+// Version is a parsed semantic version triple.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0, or 1 if v is less than, equal to, or greater than other.
+func (v Version) Compare(other Version) int {
+	switch {
+	case v.Major != other.Major:
+		return sign(v.Major - other.Major)
+	case v.Minor != other.Minor:
+		return sign(v.Minor - other.Minor)
+	default:
+		return sign(v.Patch - other.Patch)
+	}
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ParseVersion extracts the first semantic version match from free-form CLI
+// output, such as what a tool prints in response to `--version`.
+func ParseVersion(output string) (Version, error) {
+	match := versionPattern.FindStringSubmatch(output)
+	if match == nil {
+		return Version{}, fmt.Errorf("no version found in output: %q", strings.TrimSpace(output))
+	}
+
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	patch, _ := strconv.Atoi(match[3])
+	return Version{Major: major, Minor: minor, Patch: patch}, nil
+}
+
+// DetectCommand runs a version probe command with a bounded timeout and
+// parses the first semantic version out of its combined output.
+func DetectCommand(timeout time.Duration, name string, args ...string) (Version, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	if err != nil && len(out) == 0 {
+		return Version{}, fmt.Errorf("failed to run %s %s: %w", name, strings.Join(args, " "), err)
+	}
+
+	return ParseVersion(string(out))
+}